@@ -0,0 +1,93 @@
+package crunchyroll
+
+import (
+	"sync"
+	"time"
+)
+
+// deadlineTimer implements read/write deadline bookkeeping for Crunchyroll (and,
+// analogously, Format/Stream download operations), modeled after gVisor's gonet
+// adapter: each direction owns a cancel channel that is closed once its
+// deadline is reached, plus a timer that can be rearmed as the deadline moves.
+type deadlineTimer struct {
+	mu sync.Mutex
+
+	readCancel chan struct{}
+	readTimer  *time.Timer
+
+	writeCancel chan struct{}
+	writeTimer  *time.Timer
+}
+
+// init must be called once before the timer is used.
+func (d *deadlineTimer) init() {
+	d.readCancel = make(chan struct{})
+	d.writeCancel = make(chan struct{})
+}
+
+// setDeadline arms cancelCh to close at t, stopping and replacing any
+// previously scheduled timer. A zero t clears the deadline; a t already in the
+// past closes cancelCh immediately.
+func setDeadline(cancelCh *chan struct{}, timer **time.Timer, t time.Time) {
+	if *timer != nil {
+		(*timer).Stop()
+		*timer = nil
+	}
+
+	select {
+	case <-*cancelCh:
+		// already fired for a previous deadline, hand out a fresh channel.
+		*cancelCh = make(chan struct{})
+	default:
+	}
+
+	if t.IsZero() {
+		return
+	}
+
+	if until := time.Until(t); until <= 0 {
+		close(*cancelCh)
+	} else {
+		ch := *cancelCh
+		*timer = time.AfterFunc(until, func() { close(ch) })
+	}
+}
+
+// SetReadDeadline sets the deadline for read operations, after which
+// in-flight and future requests are canceled and fail with context.Canceled.
+// A zero time removes the deadline.
+func (d *deadlineTimer) SetReadDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	setDeadline(&d.readCancel, &d.readTimer, t)
+}
+
+// SetWriteDeadline sets the deadline for write operations. A zero time removes
+// the deadline.
+func (d *deadlineTimer) SetWriteDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	setDeadline(&d.writeCancel, &d.writeTimer, t)
+}
+
+// SetDeadline sets both the read and write deadline to t.
+func (d *deadlineTimer) SetDeadline(t time.Time) {
+	d.SetReadDeadline(t)
+	d.SetWriteDeadline(t)
+}
+
+// readCancelCh returns the channel that closes once the read deadline, if any,
+// is reached.
+func (d *deadlineTimer) readCancelCh() chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readCancel
+}
+
+// writeCancelCh returns the channel that closes once the write deadline, if
+// any, is reached.
+func (d *deadlineTimer) writeCancelCh() chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeCancel
+}
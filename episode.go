@@ -97,13 +97,9 @@ const (
 
 // EpisodeFromID returns an episode by its api id.
 func EpisodeFromID(crunchy *Crunchyroll, id string) (*Episode, error) {
-	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/episodes/%s?locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		crunchy.Config.Bucket,
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/episodes/%s?locale=%s",
 		id,
-		crunchy.Locale,
-		crunchy.Config.Signature,
-		crunchy.Config.Policy,
-		crunchy.Config.KeyPairID), http.MethodGet)
+		crunchy.Locale), http.MethodGet)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +116,7 @@ func EpisodeFromID(crunchy *Crunchyroll, id string) (*Episode, error) {
 	}
 	if episode.Playback != "" {
 		streamHref := jsonBody["__links__"].(map[string]interface{})["streams"].(map[string]interface{})["href"].(string)
-		if match := regexp.MustCompile(`(?m)^/cms/v2/\S+videos/(\w+)/streams$`).FindAllStringSubmatch(streamHref, -1); len(match) > 0 {
+		if match := regexp.MustCompile(`(?m)^/content/v2/cms/\S+videos/(\w+)/streams$`).FindAllStringSubmatch(streamHref, -1); len(match) > 0 {
 			episode.StreamID = match[0][1]
 		}
 	}
@@ -159,7 +155,17 @@ func (e *Episode) RemoveFromWatchlist() error {
 // this method on the first episode of the season.
 // Will fail if no streams are available, thus use Episode.Available
 // to prevent any misleading errors.
+//
+// If Crunchyroll.ExperimentalFixes has FixMissingAudioLocale set, the locale is
+// first guessed from Episode.SlugTitle, which also works around the audio
+// locale occasionally being missing from the streams Crunchyroll returns.
 func (e *Episode) AudioLocale() (LOCALE, error) {
+	if e.crunchy.ExperimentalFixes&FixMissingAudioLocale != 0 {
+		if locale := parseLocaleFromSlug(e.SlugTitle); locale != "" {
+			return locale, nil
+		}
+	}
+
 	streams, err := e.Streams()
 	if err != nil {
 		return "", err
@@ -325,13 +331,9 @@ func (e *Episode) Streams() ([]*Stream, error) {
 		return e.children, nil
 	}
 
-	streams, err := fromVideoStreams(e.crunchy, fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/videos/%s/streams?locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		e.crunchy.Config.Bucket,
+	streams, err := fromVideoStreams(e.crunchy, fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/videos/%s/streams?locale=%s",
 		e.StreamID,
-		e.crunchy.Locale,
-		e.crunchy.Config.Signature,
-		e.crunchy.Config.Policy,
-		e.crunchy.Config.KeyPairID))
+		e.crunchy.Locale))
 	if err != nil {
 		return nil, err
 	}
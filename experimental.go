@@ -0,0 +1,103 @@
+package crunchyroll
+
+import "strings"
+
+// ExperimentalFixes is a bitmask of opt-in workarounds for places where the
+// official Crunchyroll api behaves inconsistently. None of them are enabled by
+// default since they trade a bit of accuracy for not having to hit the network.
+type ExperimentalFixes uint8
+
+const (
+	// FixMissingAudioLocale makes Episode.AudioLocale and Season.AudioLocale try
+	// to derive the locale from the episode's / season's slug title before
+	// falling back to Episode.Streams, which Crunchyroll sometimes returns
+	// without an audio locale at all (commonly on dub seasons).
+	FixMissingAudioLocale ExperimentalFixes = 1 << iota
+)
+
+// parseLocaleFromSlug derives a LOCALE from slug suffixes like "-english-dub"
+// or "-japanese-audio", falling back to JP if the slug carries no language
+// suffix at all (Crunchyroll leaves the original Japanese version unsuffixed).
+// Returns an empty LOCALE only if the suffix present is not recognized.
+func parseLocaleFromSlug(slugTitle string) LOCALE {
+	slug := strings.TrimSuffix(slugTitle, "-dub")
+	slug = strings.TrimSuffix(slug, "-audio")
+
+	switch {
+	case strings.HasSuffix(slug, "-english-in"):
+		return INE
+	case strings.HasSuffix(slug, "-english"):
+		return US
+	case strings.HasSuffix(slug, "-castilian"):
+		return ES
+	case strings.HasSuffix(slug, "-french"):
+		return FR
+	case strings.HasSuffix(slug, "-german"):
+		return DE
+	case strings.HasSuffix(slug, "-hindi"):
+		return IN
+	case strings.HasSuffix(slug, "-italian"):
+		return IT
+	case strings.HasSuffix(slug, "-brazilian"), strings.HasSuffix(slug, "-portuguese-br"):
+		return BR
+	case strings.HasSuffix(slug, "-portuguese"):
+		return PT
+	case strings.HasSuffix(slug, "-russian"):
+		return RU
+	case strings.HasSuffix(slug, "-spanish"):
+		return LA
+	case strings.HasSuffix(slug, "-arabic"):
+		return AR
+	case strings.HasSuffix(slug, "-japanese"), slug == slugTitle:
+		return JP
+	default:
+		return ""
+	}
+}
+
+// Stabilization identifies an opt-in workaround toggled via
+// Crunchyroll.SetStabilizations, named after crunchyroll-rs's
+// "experimental-stabilizations" feature that it was ported from.
+type Stabilization uint8
+
+const (
+	// StabilizationAudioFromSlug is the crunchyroll-rs name for the same
+	// workaround as ExperimentalFixes' FixMissingAudioLocale: infer the audio
+	// locale from the slug title before falling back to a network request.
+	StabilizationAudioFromSlug Stabilization = Stabilization(FixMissingAudioLocale)
+)
+
+// SetStabilizations enables the given stabilizations. They map onto
+// Crunchyroll.ExperimentalFixes under the hood, just exposed under the naming
+// crunchyroll-rs uses for anyone porting code from there.
+func (c *Crunchyroll) SetStabilizations(stabilizations ...Stabilization) {
+	for _, s := range stabilizations {
+		c.ExperimentalFixes |= ExperimentalFixes(s)
+	}
+}
+
+// AudioLocale returns the audio locale of the season.
+// If Crunchyroll.ExperimentalFixes has FixMissingAudioLocale set, the locale is
+// first read from Season.Locale (populated by Series.Seasons from the slug
+// title, avoiding a request to the first episode's streams). Falls back to
+// fetching the first episode's AudioLocale if the slug does not carry a
+// recognizable suffix.
+func (s *Season) AudioLocale() (LOCALE, error) {
+	if s.crunchy.ExperimentalFixes&FixMissingAudioLocale != 0 {
+		if s.Locale != "" {
+			return s.Locale, nil
+		}
+		if locale := parseLocaleFromSlug(s.SlugTitle); locale != "" {
+			return locale, nil
+		}
+	}
+
+	episodes, err := s.Episodes()
+	if err != nil {
+		return "", err
+	}
+	if len(episodes) == 0 {
+		return "", nil
+	}
+	return episodes[0].AudioLocale()
+}
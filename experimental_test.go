@@ -0,0 +1,35 @@
+package crunchyroll
+
+import "testing"
+
+func TestParseLocaleFromSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want LOCALE
+	}{
+		{"attack-on-titan-english-dub", US},
+		{"attack-on-titan-english-in-dub", INE},
+		{"attack-on-titan-castilian-dub", ES},
+		{"attack-on-titan-french-dub", FR},
+		{"attack-on-titan-german-dub", DE},
+		{"attack-on-titan-hindi-dub", IN},
+		{"attack-on-titan-italian-dub", IT},
+		{"attack-on-titan-brazilian-dub", BR},
+		{"attack-on-titan-portuguese-br-dub", BR},
+		{"attack-on-titan-portuguese-dub", PT},
+		{"attack-on-titan-russian-dub", RU},
+		{"attack-on-titan-spanish-dub", LA},
+		{"attack-on-titan-arabic-dub", AR},
+		{"attack-on-titan-japanese-audio", JP},
+		{"attack-on-titan", JP},
+		{"attack-on-titan-korean-dub", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			if got := parseLocaleFromSlug(tt.slug); got != tt.want {
+				t.Errorf("parseLocaleFromSlug(%q) = %q, want %q", tt.slug, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package crunchyroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// SessionProvider obtains a short-lived session that unblocks Crunchyroll's
+// region-locked catalog, used by GeoBypass.
+type SessionProvider interface {
+	// Session returns a session id that can be attached as a cookie to unblock
+	// requests, along with the country code it unblocks to.
+	Session(ctx context.Context, client *http.Client) (sessionID string, country string, err error)
+}
+
+// unblockProxyProvider is the default SessionProvider. It asks a configurable
+// unblock proxy for a session id, the way e.g. cr-unblocker does.
+type unblockProxyProvider struct {
+	// Endpoint is the unblock proxy's session endpoint, queried with a
+	// device_id parameter. Defaults to https://cr-unblocker.us.to/start_session.
+	Endpoint string
+	// DeviceID is sent to the proxy so it can keep returning the same session
+	// for repeated calls. A random id is generated if left empty.
+	DeviceID string
+}
+
+func (p *unblockProxyProvider) Session(ctx context.Context, client *http.Client) (string, string, error) {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = "https://cr-unblocker.us.to/start_session"
+	}
+	deviceID := p.DeviceID
+	if deviceID == "" {
+		deviceID = randomDeviceID()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?device_id=%s", endpoint, deviceID), nil)
+	if err != nil {
+		return "", "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		SessionID string `json:"session_id"`
+		Country   string `json:"country"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", "", fmt.Errorf("failed to decode unblock session response: %w", err)
+	}
+	return body.SessionID, body.Country, nil
+}
+
+// randomDeviceID generates a device id for providers that don't have one
+// configured, so repeated EnableGeoBypass calls without an explicit DeviceID
+// don't all share the literal same id.
+func randomDeviceID() string {
+	const charset = "abcdef0123456789"
+	id := make([]byte, 16)
+	for i := range id {
+		id[i] = charset[rand.Intn(len(charset))]
+	}
+	return "crunchyroll-go-" + string(id)
+}
+
+// GeoBypass installs a transport on a Crunchyroll instance that transparently
+// retries geo-blocked requests through a SessionProvider.
+// Use Crunchyroll.EnableGeoBypass to install one.
+type GeoBypass struct {
+	// Provider obtains the unblock session. Defaults to an unblockProxyProvider
+	// if left nil.
+	Provider SessionProvider
+	// TTL controls how long an obtained session is reused before a fresh one is
+	// requested. Defaults to 10 minutes if zero.
+	TTL time.Duration
+
+	next http.RoundTripper
+
+	mutex     sync.Mutex
+	sessionID string
+	country   string
+	expiry    time.Time
+}
+
+// EnableGeoBypass wraps c.Client's transport so geo-blocked requests are
+// transparently retried through provider's unblock session. Pass nil to use
+// the default unblock proxy provider. The returned GeoBypass exposes Country
+// once a session has been acquired.
+func (c *Crunchyroll) EnableGeoBypass(provider SessionProvider) *GeoBypass {
+	next := c.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	gb := &GeoBypass{Provider: provider, next: next}
+	if gb.Provider == nil {
+		gb.Provider = &unblockProxyProvider{}
+	}
+
+	client := *c.Client
+	client.Transport = gb
+	c.Client = &client
+
+	return gb
+}
+
+// Country returns the country code the currently cached unblock session
+// resolves to, or an empty string if no session has been acquired yet.
+func (g *GeoBypass) Country() string {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.country
+}
+
+// session returns a cached unblock session, acquiring a new one via Provider
+// if none is cached yet or the cached one has expired. Concurrent callers
+// share a single in-flight acquisition.
+func (g *GeoBypass) session(ctx context.Context) (string, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.sessionID != "" && time.Now().Before(g.expiry) {
+		return g.sessionID, nil
+	}
+
+	sessionID, country, err := g.Provider.Session(ctx, &http.Client{Transport: g.next})
+	if err != nil {
+		return "", err
+	}
+
+	ttl := g.TTL
+	if ttl == 0 {
+		ttl = 10 * time.Minute
+	}
+	g.sessionID = sessionID
+	g.country = country
+	g.expiry = time.Now().Add(ttl)
+
+	return g.sessionID, nil
+}
+
+// cachedSession returns the currently cached unblock session id, if one has
+// been acquired and hasn't expired yet.
+func (g *GeoBypass) cachedSession() (string, bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.sessionID != "" && time.Now().Before(g.expiry) {
+		return g.sessionID, true
+	}
+	return "", false
+}
+
+// RoundTrip implements http.RoundTripper.
+func (g *GeoBypass) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	if sessionID, ok := g.cachedSession(); ok {
+		attemptReq = req.Clone(req.Context())
+		attemptReq.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+	}
+
+	resp, err := g.next.RoundTrip(attemptReq)
+	if err != nil || !isGeoBlocked(resp) {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	sessionID, err := g.session(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("geo bypass: failed to acquire unblock session: %w", err)
+	}
+
+	retry := req.Clone(req.Context())
+	retry.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+	return g.next.RoundTrip(retry)
+}
+
+// isGeoBlocked reports whether resp is Crunchyroll's geo-block response,
+// restoring its body afterwards so it can still be read by the caller.
+func isGeoBlocked(resp *http.Response) bool {
+	if resp == nil || (resp.StatusCode != http.StatusForbidden && resp.StatusCode != 451) {
+		return false
+	}
+
+	var buf bytes.Buffer
+	io.Copy(&buf, resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(&buf)
+
+	var errBody struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &errBody); err != nil {
+		return false
+	}
+	return errBody.Error == "GeoRestrictedError"
+}
@@ -0,0 +1,104 @@
+package crunchyroll
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// History returns the account's watch history, newest first.
+func (c *Crunchyroll) History(page uint, size uint) (BulkResult[*HistoryEpisode], error) {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/content/v2/%s/watch-history?page=%d&page_size=%d&locale=%s",
+		c.Config.AccountID, page, size, c.Locale)
+	resp, err := c.request(endpoint, http.MethodGet)
+	if err != nil {
+		return BulkResult[*HistoryEpisode]{}, err
+	}
+	defer resp.Body.Close()
+
+	var result BulkResult[*HistoryEpisode]
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return BulkResult[*HistoryEpisode]{}, fmt.Errorf("failed to parse watch history response: %w", err)
+	}
+
+	for _, historyEpisode := range result.Items {
+		historyEpisode.Episode.crunchy = c
+	}
+	return result, nil
+}
+
+// PlayheadInfo holds the current playback progress of a piece of content.
+type PlayheadInfo struct {
+	Playhead     uint `json:"playhead"`
+	FullyWatched bool `json:"fully_watched"`
+}
+
+// Playheads returns the playback progress of multiple pieces of content at
+// once, keyed by content id. Content ids without any recorded progress are
+// absent from the result.
+func (c *Crunchyroll) Playheads(contentIDs []string) (map[string]PlayheadInfo, error) {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/content/v2/%s/playheads?content_ids=%s&locale=%s",
+		c.Config.AccountID, strings.Join(contentIDs, ","), c.Locale)
+	resp, err := c.request(endpoint, http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var jsonBody struct {
+		Data []struct {
+			ContentID string `json:"content_id"`
+			PlayheadInfo
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&jsonBody); err != nil {
+		return nil, fmt.Errorf("failed to parse playheads response: %w", err)
+	}
+
+	playheads := make(map[string]PlayheadInfo, len(jsonBody.Data))
+	for _, entry := range jsonBody.Data {
+		playheads[entry.ContentID] = entry.PlayheadInfo
+	}
+	return playheads, nil
+}
+
+// Playhead returns the episode's current playback progress in seconds, and
+// whether it has been fully watched.
+func (e *Episode) Playhead() (uint, bool, error) {
+	playheads, err := e.crunchy.Playheads([]string{e.ID})
+	if err != nil {
+		return 0, false, err
+	}
+	info, ok := playheads[e.ID]
+	if !ok {
+		return 0, false, nil
+	}
+	return info.Playhead, info.FullyWatched, nil
+}
+
+// SetPlayhead updates the episode's playback progress to positionSeconds.
+func (e *Episode) SetPlayhead(positionSeconds uint) error {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/content/v2/%s/playheads?locale=%s", e.crunchy.Config.AccountID, e.crunchy.Locale)
+	body, _ := json.Marshal(map[string]any{"content_id": e.ID, "playhead": positionSeconds})
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	_, err = e.crunchy.requestFull(req)
+	return err
+}
+
+// MarkWatched marks the episode as fully watched.
+func (e *Episode) MarkWatched() error {
+	return e.SetPlayhead(uint(e.DurationMS / 1000))
+}
+
+// ClearHistory removes the episode from the account's watch history.
+func (e *Episode) ClearHistory() error {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/content/v2/%s/watch-history/%s?locale=%s", e.crunchy.Config.AccountID, e.ID, e.crunchy.Locale)
+	_, err := e.crunchy.request(endpoint, http.MethodDelete)
+	return err
+}
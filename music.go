@@ -0,0 +1,284 @@
+package crunchyroll
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// MusicVideo contains information about a music video.
+type MusicVideo struct {
+	video
+	Video
+
+	crunchy *Crunchyroll
+
+	children []*Stream
+
+	MediaType MediaType `json:"type"`
+
+	ArtistID   string `json:"artist_id"`
+	ArtistName string `json:"artist_name"`
+
+	DurationMS    int  `json:"duration_ms"`
+	IsMature      bool `json:"is_mature"`
+	IsPremiumOnly bool `json:"is_premium_only"`
+	MatureBlocked bool `json:"mature_blocked"`
+
+	StreamID string
+}
+
+// MusicVideoFromID returns a music video by its api id.
+func MusicVideoFromID(crunchy *Crunchyroll, id string) (*MusicVideo, error) {
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/music_videos/%s?locale=%s",
+		id,
+		crunchy.Locale), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var jsonBody map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&jsonBody)
+
+	musicVideo := &MusicVideo{
+		crunchy: crunchy,
+	}
+	musicVideo.ID = id
+	if err = decodeMapToStruct(jsonBody, musicVideo); err != nil {
+		return nil, err
+	}
+	musicVideo.StreamID = extractMusicStreamID(jsonBody)
+
+	return musicVideo, nil
+}
+
+// Streams returns all streams which are available for the music video.
+func (mv *MusicVideo) Streams() ([]*Stream, error) {
+	if mv.children != nil {
+		return mv.children, nil
+	}
+
+	streams, err := fromVideoStreams(mv.crunchy, fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/music_videos/%s/streams?locale=%s",
+		mv.StreamID,
+		mv.crunchy.Locale))
+	if err != nil {
+		return nil, err
+	}
+
+	if mv.crunchy.cache {
+		mv.children = streams
+	}
+	return streams, nil
+}
+
+// Concert contains information about a concert.
+type Concert struct {
+	video
+	Video
+
+	crunchy *Crunchyroll
+
+	children []*Stream
+
+	MediaType MediaType `json:"type"`
+
+	ArtistID   string `json:"artist_id"`
+	ArtistName string `json:"artist_name"`
+
+	DurationMS    int  `json:"duration_ms"`
+	IsMature      bool `json:"is_mature"`
+	IsPremiumOnly bool `json:"is_premium_only"`
+	MatureBlocked bool `json:"mature_blocked"`
+
+	StreamID string
+}
+
+// ConcertFromID returns a concert by its api id.
+func ConcertFromID(crunchy *Crunchyroll, id string) (*Concert, error) {
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/concerts/%s?locale=%s",
+		id,
+		crunchy.Locale), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var jsonBody map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&jsonBody)
+
+	concert := &Concert{
+		crunchy: crunchy,
+	}
+	concert.ID = id
+	if err = decodeMapToStruct(jsonBody, concert); err != nil {
+		return nil, err
+	}
+	concert.StreamID = extractMusicStreamID(jsonBody)
+
+	return concert, nil
+}
+
+// Streams returns all streams which are available for the concert.
+func (c *Concert) Streams() ([]*Stream, error) {
+	if c.children != nil {
+		return c.children, nil
+	}
+
+	streams, err := fromVideoStreams(c.crunchy, fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/concerts/%s/streams?locale=%s",
+		c.StreamID,
+		c.crunchy.Locale))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.crunchy.cache {
+		c.children = streams
+	}
+	return streams, nil
+}
+
+// extractMusicStreamID pulls the stream id out of the __links__ block the
+// music endpoints return, the same way Episode does it for video streams.
+func extractMusicStreamID(jsonBody map[string]interface{}) string {
+	links, ok := jsonBody["__links__"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	streams, ok := links["streams"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, ok := streams["href"].(string)
+	if !ok {
+		return ""
+	}
+	if match := regexp.MustCompile(`(?m)^/content/v2/music/\S+/(\w+)/streams$`).FindAllStringSubmatch(href, -1); len(match) > 0 {
+		return match[0][1]
+	}
+	return ""
+}
+
+// musicURLPattern recognizes a crunchyroll.com music video, concert or artist
+// watch url and captures its content type and id.
+var musicURLPattern = regexp.MustCompile(`(?m)^https?://(?:www\.)?crunchyroll\.com/(?:watch/(musicvideo|concert)|(artist))/(\w+)`)
+
+// MusicFromURL resolves a crunchyroll.com music video, concert or artist url
+// to the matching MusicVideo, Concert or Artist, the same way FindVideoByURL-style
+// helpers dispatch other urls to their content type.
+func MusicFromURL(crunchy *Crunchyroll, url string) (Video, error) {
+	match := musicURLPattern.FindStringSubmatch(url)
+	if match == nil {
+		return nil, fmt.Errorf("url does not point to a music video, concert or artist")
+	}
+
+	switch match[1] {
+	case "musicvideo":
+		return MusicVideoFromID(crunchy, match[3])
+	case "concert":
+		return ConcertFromID(crunchy, match[3])
+	default:
+		return ArtistFromID(crunchy, match[3])
+	}
+}
+
+// Artist contains information about a music artist.
+type Artist struct {
+	video
+	Video
+
+	crunchy *Crunchyroll
+
+	musicVideoChildren []*MusicVideo
+	concertChildren    []*Concert
+
+	MediaType MediaType `json:"type"`
+}
+
+// ArtistFromID returns an artist by its api id.
+func ArtistFromID(crunchy *Crunchyroll, id string) (*Artist, error) {
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/artists/%s?locale=%s",
+		id,
+		crunchy.Locale), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var jsonBody map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&jsonBody)
+
+	artist := &Artist{
+		crunchy: crunchy,
+	}
+	artist.ID = id
+	if err = decodeMapToStruct(jsonBody, artist); err != nil {
+		return nil, err
+	}
+
+	return artist, nil
+}
+
+// MusicVideos returns all music videos released by the artist.
+func (a *Artist) MusicVideos() ([]*MusicVideo, error) {
+	if a.musicVideoChildren != nil {
+		return a.musicVideoChildren, nil
+	}
+
+	resp, err := a.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/artists/%s/music_videos?locale=%s",
+		a.ID,
+		a.crunchy.Locale), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var jsonBody map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&jsonBody)
+
+	var musicVideos []*MusicVideo
+	for _, item := range jsonBody["items"].([]interface{}) {
+		musicVideo := &MusicVideo{
+			crunchy: a.crunchy,
+		}
+		if err = decodeMapToStruct(item, musicVideo); err != nil {
+			return nil, err
+		}
+		musicVideos = append(musicVideos, musicVideo)
+	}
+
+	if a.crunchy.cache {
+		a.musicVideoChildren = musicVideos
+	}
+	return musicVideos, nil
+}
+
+// Concerts returns all concerts released by the artist.
+func (a *Artist) Concerts() ([]*Concert, error) {
+	if a.concertChildren != nil {
+		return a.concertChildren, nil
+	}
+
+	resp, err := a.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/music/artists/%s/concerts?locale=%s",
+		a.ID,
+		a.crunchy.Locale), http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var jsonBody map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&jsonBody)
+
+	var concerts []*Concert
+	for _, item := range jsonBody["items"].([]interface{}) {
+		concert := &Concert{
+			crunchy: a.crunchy,
+		}
+		if err = decodeMapToStruct(item, concert); err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, concert)
+	}
+
+	if a.crunchy.cache {
+		a.concertChildren = concerts
+	}
+	return concerts, nil
+}
@@ -0,0 +1,67 @@
+package party
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net"
+)
+
+// dial opens a plain or TLS TCP connection to host, depending on useTLS.
+func dial(host string, useTLS bool) (net.Conn, error) {
+	if useTLS {
+		return tls.Dial("tcp", host, nil)
+	}
+	return net.Dial("tcp", host)
+}
+
+// Conn is a participant's side of a joined Room. Events read off the room are
+// delivered on the channel returned by JoinRoom; Send publishes an event (a
+// chat message, or Play/Pause/Seek/RateChange) to everyone else in the room.
+// The room relays events from any participant; it does not check who sent them.
+type Conn struct {
+	ws *wsConn
+}
+
+// Send publishes event to the rest of the room.
+func (c *Conn) Send(event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return c.ws.writeText(payload)
+}
+
+// Close leaves the room.
+func (c *Conn) Close() error {
+	return c.ws.close()
+}
+
+// JoinRoom joins the room at url (e.g. "ws://host:port/?id=alice", whose id
+// query parameter names the participant), sending token as a bearer
+// Authorization header, which the Room does not check. It returns a Conn to
+// publish events on and a channel events are delivered on. The channel is
+// closed once the room is left or the connection drops.
+func JoinRoom(url, token string) (*Conn, <-chan Event, error) {
+	ws, err := dialClient(url, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for {
+			payload, err := ws.readMessage()
+			if err != nil {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal(payload, &event); err != nil {
+				continue
+			}
+			events <- event
+		}
+	}()
+
+	return &Conn{ws: ws}, events, nil
+}
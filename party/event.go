@@ -0,0 +1,47 @@
+// Package party turns a Crunchyroll session into the backend for a
+// synchronized viewing room: one host resolves an Episode through the usual
+// Format/Subtitle machinery, each Room serves its own websocket listener that
+// peers join with a participant id (the join url carries no room id), and
+// Play/Pause/Seek/RateChange events - plus chat and bullet chat (danmaku) -
+// sent by any participant are broadcast to everyone else connected.
+package party
+
+import "github.com/ottdump/crunchyroll-go/v3"
+
+// EventType identifies the kind of message broadcast in a Room.
+type EventType string
+
+const (
+	// EventSync is sent to a client right after it joins, carrying the
+	// Format resolved for it through the host's session.
+	EventSync EventType = "sync"
+
+	EventPlay       EventType = "play"
+	EventPause      EventType = "pause"
+	EventSeek       EventType = "seek"
+	EventRateChange EventType = "rate_change"
+
+	EventChat    EventType = "chat"
+	EventDanmaku EventType = "danmaku"
+)
+
+// Event is a single message exchanged between a Room and its participants.
+// Which fields are populated depends on Type.
+type Event struct {
+	Type EventType `json:"type"`
+	// From is the id of the participant the event originated from, or "room"
+	// for EventSync. The server overwrites it on every incoming event, so a
+	// client cannot impersonate another participant.
+	From string `json:"from,omitempty"`
+
+	// PositionMS is the playback position in milliseconds, set for
+	// EventPlay, EventPause and EventSeek.
+	PositionMS int64 `json:"position_ms,omitempty"`
+	// Rate is the new playback rate, set for EventRateChange.
+	Rate float64 `json:"rate,omitempty"`
+	// Message is the chat or danmaku text, set for EventChat and EventDanmaku.
+	Message string `json:"message,omitempty"`
+
+	// Format is the stream the room is synchronized to, set on EventSync.
+	Format *crunchyroll.Format `json:"format,omitempty"`
+}
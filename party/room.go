@@ -0,0 +1,196 @@
+package party
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/ottdump/crunchyroll-go/v3"
+)
+
+// source is the subset of crunchyroll.Episode a Room needs to resolve the
+// same Format every participant streams, without pulling in the rest of the
+// episode (or, once crunchyroll-go can resolve a MovieListing by id directly,
+// a movie).
+type source interface {
+	Available() bool
+	GetFormat(resolution string, subtitle crunchyroll.LOCALE, hardsub bool) (*crunchyroll.Format, error)
+}
+
+// client is a single participant connected to a Room.
+type client struct {
+	id   string
+	conn *wsConn
+	send chan Event
+}
+
+// Room is a synchronized viewing session backed by a single host's
+// Crunchyroll session. Every participant's Format is resolved through the
+// host's session, so premium-gated streams are controlled by the host's
+// Config.Premium rather than each peer's own account. Each participant picks
+// resolution, subtitle and hardsub in its own join url, so participants that
+// ask for different ones are handed different Formats.
+type Room struct {
+	ID string
+
+	ctx    context.Context
+	source source
+
+	mu      sync.Mutex
+	clients map[string]*client
+}
+
+// NewRoom creates a watch-party room for the media identified by id. Only
+// crunchyroll.MediaTypeEpisode is supported right now: crunchyroll-go has no
+// endpoint that resolves a MovieListing directly by id (only by walking
+// Movie.MovieListing), so movie rooms aren't wired up yet.
+func NewRoom(ctx context.Context, c *crunchyroll.Crunchyroll, media crunchyroll.MediaType, id string) (*Room, error) {
+	var src source
+	switch media {
+	case crunchyroll.MediaTypeEpisode:
+		episode, err := crunchyroll.EpisodeFromID(c, id)
+		if err != nil {
+			return nil, err
+		}
+		src = episode
+	default:
+		return nil, fmt.Errorf("party: unsupported media type %q", media)
+	}
+
+	return &Room{
+		ID:      id,
+		ctx:     ctx,
+		source:  src,
+		clients: map[string]*client{},
+	}, nil
+}
+
+// Format resolves the stream every participant of the room should play,
+// refusing premium-only streams unless the host's Crunchyroll session itself
+// has premium.
+func (r *Room) Format(resolution string, subtitle crunchyroll.LOCALE, hardsub bool) (*crunchyroll.Format, error) {
+	if !r.source.Available() {
+		return nil, fmt.Errorf("party: stream requires a premium account")
+	}
+	return r.source.GetFormat(resolution, subtitle, hardsub)
+}
+
+// Serve accepts participants on listener until r's context is canceled or the
+// listener is closed.
+func (r *Room) Serve(listener net.Listener) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", r.handleJoin)
+	srv := &http.Server{Handler: mux}
+
+	if r.ctx != nil {
+		go func() {
+			<-r.ctx.Done()
+			srv.Close()
+		}()
+	}
+
+	err := srv.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (r *Room) handleJoin(w http.ResponseWriter, req *http.Request) {
+	id := req.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "missing participant id", http.StatusBadRequest)
+		return
+	}
+
+	resolution := req.URL.Query().Get("resolution")
+	if resolution == "" {
+		resolution = "best"
+	}
+	subtitle := crunchyroll.LOCALE(req.URL.Query().Get("subtitle"))
+	hardsub := req.URL.Query().Get("hardsub") == "true"
+
+	format, err := r.Format(resolution, subtitle, hardsub)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	conn, err := upgradeServer(w, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c := &client{id: id, conn: conn, send: make(chan Event, 16)}
+	r.join(c)
+	defer r.leave(c)
+
+	c.send <- Event{Type: EventSync, From: "room", Format: format}
+	go c.writeLoop()
+	r.readLoop(c)
+}
+
+func (r *Room) join(c *client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clients[c.id] = c
+}
+
+func (r *Room) leave(c *client) {
+	r.mu.Lock()
+	delete(r.clients, c.id)
+	r.mu.Unlock()
+	close(c.send)
+	c.conn.close()
+}
+
+// readLoop relays every event c sends in to the rest of the room until c
+// disconnects.
+func (r *Room) readLoop(c *client) {
+	for {
+		payload, err := c.conn.readMessage()
+		if err != nil {
+			return
+		}
+		var event Event
+		if err := json.Unmarshal(payload, &event); err != nil {
+			continue
+		}
+		event.From = c.id
+		r.broadcast(event, c.id)
+	}
+}
+
+// broadcast sends event to every participant except the one it came from. A
+// participant whose send buffer is full misses the event rather than blocking
+// the rest of the room; it stays connected.
+func (r *Room) broadcast(event Event, exceptID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, c := range r.clients {
+		if id == exceptID {
+			continue
+		}
+		select {
+		case c.send <- event:
+		default:
+		}
+	}
+}
+
+func (c *client) writeLoop() {
+	for event := range c.send {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			continue
+		}
+		if err := c.conn.writeText(payload); err != nil {
+			return
+		}
+	}
+}
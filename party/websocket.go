@@ -0,0 +1,296 @@
+package party
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// websocketGUID is the magic value RFC 6455 has the server concatenate with
+// the client's Sec-WebSocket-Key during the handshake.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	wsOpText  = 0x1
+	wsOpClose = 0x8
+	wsOpPing  = 0x9
+	wsOpPong  = 0xA
+)
+
+// maxFrameSize caps how large a single incoming frame's payload is allowed to
+// be. party only ever exchanges small JSON Events, so this is generous
+// headroom, not a real payload size; it exists to stop a peer from declaring
+// a multi-gigabyte length and forcing a huge allocation.
+const maxFrameSize = 1 << 20 // 1 MiB
+
+// wsConn is a minimal RFC 6455 connection: just enough unfragmented text
+// framing to exchange JSON Events between a Room and its participants. There
+// is no compression or message fragmentation support, neither of which party
+// needs.
+type wsConn struct {
+	conn net.Conn
+	br   *bufio.Reader
+	// masked is true for connections that must mask outgoing frames, i.e. a
+	// client talking to a Room, per RFC 6455 section 5.1.
+	masked bool
+}
+
+// upgradeServer hijacks an incoming HTTP request and completes the server
+// side of the websocket handshake.
+func upgradeServer(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" || !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return nil, fmt.Errorf("party: not a websocket upgrade request")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		return nil, fmt.Errorf("party: response writer does not support hijacking")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	accept := acceptKey(key)
+	if _, err := fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Sec-WebSocket-Accept: %s\r\n\r\n", accept); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &wsConn{conn: conn, br: rw.Reader, masked: false}, nil
+}
+
+// dialClient opens rawURL as a TCP connection and completes the client side
+// of the websocket handshake, sending token as a bearer Authorization header.
+func dialClient(rawURL, token string) (*wsConn, error) {
+	host, path, useTLS, err := parseWSURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dial(host, useTLS)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes := make([]byte, 16)
+	if _, err := rand.Read(keyBytes); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(keyBytes)
+
+	req := fmt.Sprintf("GET %s HTTP/1.1\r\n"+
+		"Host: %s\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Sec-WebSocket-Key: %s\r\n"+
+		"Sec-WebSocket-Version: 13\r\n", path, host, key)
+	if token != "" {
+		req += fmt.Sprintf("Authorization: Bearer %s\r\n", token)
+	}
+	req += "\r\n"
+
+	if _, err := conn.Write([]byte(req)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, &http.Request{Method: http.MethodGet})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return nil, fmt.Errorf("party: websocket handshake failed with status %s", resp.Status)
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, fmt.Errorf("party: websocket handshake failed Sec-WebSocket-Accept check")
+	}
+
+	return &wsConn{conn: conn, br: br, masked: true}, nil
+}
+
+// acceptKey computes the Sec-WebSocket-Accept value for a given
+// Sec-WebSocket-Key, per RFC 6455 section 1.3.
+func acceptKey(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// writeText sends payload as a single unfragmented text frame.
+func (c *wsConn) writeText(payload []byte) error {
+	return c.writeFrame(wsOpText, payload)
+}
+
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	var header []byte
+	header = append(header, 0x80|opcode) // FIN set, no extensions
+
+	maskBit := byte(0)
+	if c.masked {
+		maskBit = 0x80
+	}
+
+	length := len(payload)
+	switch {
+	case length <= 125:
+		header = append(header, maskBit|byte(length))
+	case length <= 65535:
+		header = append(header, maskBit|126)
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+	default:
+		header = append(header, maskBit|127)
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	if c.masked {
+		var maskKey [4]byte
+		if _, err := rand.Read(maskKey[:]); err != nil {
+			return err
+		}
+		header = append(header, maskKey[:]...)
+
+		masked := make([]byte, length)
+		for i, b := range payload {
+			masked[i] = b ^ maskKey[i%4]
+		}
+		payload = masked
+	}
+
+	if _, err := c.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(payload)
+	return err
+}
+
+// readMessage reads the next unfragmented text frame, skipping ping/pong
+// control frames, and returns its payload. It returns io.EOF once a close
+// frame is received.
+func (c *wsConn) readMessage() ([]byte, error) {
+	for {
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch opcode {
+		case wsOpText:
+			return payload, nil
+		case wsOpClose:
+			return nil, io.EOF
+		case wsOpPing:
+			if err := c.writeFrame(wsOpPong, payload); err != nil {
+				return nil, err
+			}
+		case wsOpPong:
+			// nothing to do
+		}
+	}
+}
+
+func (c *wsConn) readFrame() (opcode byte, payload []byte, err error) {
+	head := make([]byte, 2)
+	if _, err = io.ReadFull(c.br, head); err != nil {
+		return 0, nil, err
+	}
+	opcode = head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+	length := uint64(head[1] & 0x7F)
+
+	switch length {
+	case 126:
+		ext := make([]byte, 2)
+		if _, err = io.ReadFull(c.br, ext); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext))
+	case 127:
+		ext := make([]byte, 8)
+		if _, err = io.ReadFull(c.br, ext); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext)
+	}
+
+	if length > maxFrameSize {
+		return 0, nil, fmt.Errorf("party: frame payload of %d bytes exceeds the %d byte limit", length, maxFrameSize)
+	}
+
+	var maskKey [4]byte
+	if masked {
+		if _, err = io.ReadFull(c.br, maskKey[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= maskKey[i%4]
+		}
+	}
+
+	return opcode, payload, nil
+}
+
+func (c *wsConn) close() error {
+	_ = c.writeFrame(wsOpClose, nil)
+	return c.conn.Close()
+}
+
+// parseWSURL splits a ws://, wss://, http:// or https:// URL into a host and
+// request path/query, treating ws/http the same and wss/https the same.
+func parseWSURL(rawURL string) (host, path string, useTLS bool, err error) {
+	switch {
+	case strings.HasPrefix(rawURL, "wss://"):
+		useTLS = true
+		rawURL = strings.TrimPrefix(rawURL, "wss://")
+	case strings.HasPrefix(rawURL, "ws://"):
+		rawURL = strings.TrimPrefix(rawURL, "ws://")
+	case strings.HasPrefix(rawURL, "https://"):
+		useTLS = true
+		rawURL = strings.TrimPrefix(rawURL, "https://")
+	case strings.HasPrefix(rawURL, "http://"):
+		rawURL = strings.TrimPrefix(rawURL, "http://")
+	default:
+		return "", "", false, fmt.Errorf("party: unsupported websocket url %q", rawURL)
+	}
+
+	if idx := strings.Index(rawURL, "/"); idx >= 0 {
+		host, path = rawURL[:idx], rawURL[idx:]
+	} else {
+		host, path = rawURL, "/"
+	}
+	if !strings.Contains(host, ":") {
+		if useTLS {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+	return host, path, useTLS, nil
+}
@@ -0,0 +1,126 @@
+package crunchyroll
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PlaybackPlatform identifies the device profile a playback session is
+// requested for, which affects which hardsub variants and DRM systems
+// Crunchyroll offers back.
+type PlaybackPlatform string
+
+const (
+	PlaybackPlatformWebFirefox    PlaybackPlatform = "web/firefox"
+	PlaybackPlatformWebChrome     PlaybackPlatform = "web/chrome"
+	PlaybackPlatformConsoleSwitch PlaybackPlatform = "console/switch"
+	PlaybackPlatformAndroidPhone  PlaybackPlatform = "android/phone"
+)
+
+// DRM holds the license server information for a playback session, populated
+// if the requested content is DRM protected (Widevine / PlayReady).
+type DRM struct {
+	System           string `json:"system"`
+	LicenseServerURL string `json:"license_server_url"`
+	Token            string `json:"token"`
+}
+
+// PlaybackVariant is a single hardsub or audio rendition of a playback
+// session's manifest.
+type PlaybackVariant struct {
+	HardsubLocale LOCALE `json:"hardsub_locale"`
+	URL           string `json:"url"`
+}
+
+// PlaybackSession is a concurrent-stream slot obtained via
+// Episode.PlaybackStreams. Crunchyroll caps how many of these an account can
+// hold open at once, so call Close once playback is done to free the slot for
+// other devices.
+type PlaybackSession struct {
+	crunchy *Crunchyroll
+
+	// AssetID and Token identify this session for the keep-alive and release
+	// requests.
+	AssetID string `json:"assetId"`
+	Token   string `json:"token"`
+
+	// URL is the MPD/HLS manifest url for the default rendition.
+	URL string `json:"url"`
+	// Hardsubs lists the available hardsub variants of the manifest, if any.
+	Hardsubs []PlaybackVariant `json:"hardSubs"`
+	// AudioLocales lists the audio locales muxed into the manifest.
+	AudioLocales []LOCALE `json:"audioLocales"`
+
+	// DRM is set if the manifest is DRM protected.
+	DRM *DRM `json:"drm"`
+
+	// UserAgent is the user agent PlaybackStreams was called with, if any, and
+	// is also sent with the keep-alive and release requests to keep them
+	// consistent with the session that was opened.
+	UserAgent string `json:"-"`
+}
+
+// PlaybackStreams requests a streaming manifest for the episode through the
+// modern /playback/v1 endpoint, which also returns DRM license information
+// and reserves one of the account's concurrent-stream slots. userAgent, if
+// set, is sent with the /play request itself, so it's what actually
+// influences which hardsub/DRM variants the manifest comes back with; pass a
+// Nintendo Switch UA to dodge the hardsub-only restriction some regions
+// enforce on other platforms. Call PlaybackSession.Close once done with it to
+// release the slot again.
+func (e *Episode) PlaybackStreams(platform PlaybackPlatform, userAgent string) (*PlaybackSession, error) {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/playback/v1/%s/%s/play", e.ID, platform)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+	resp, err := e.crunchy.requestFull(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	session := &PlaybackSession{crunchy: e.crunchy, UserAgent: userAgent}
+	if err = json.NewDecoder(resp.Body).Decode(session); err != nil {
+		return nil, fmt.Errorf("failed to parse playback session response: %w", err)
+	}
+
+	return session, nil
+}
+
+// KeepAlive extends the concurrent-stream slot this session holds. Call it
+// periodically while still playing to prevent Crunchyroll from reclaiming the
+// slot for another device.
+func (p *PlaybackSession) KeepAlive() error {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/playback/v1/%s/active_stream", p.AssetID)
+	body, _ := json.Marshal(map[string]string{"token": p.Token})
+	req, err := http.NewRequest(http.MethodPatch, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+	_, err = p.crunchy.requestFull(req)
+	return err
+}
+
+// Close releases the concurrent-stream slot this session holds.
+func (p *PlaybackSession) Close() error {
+	endpoint := fmt.Sprintf("https://www.crunchyroll.com/playback/v1/%s/active_stream?token=%s", p.AssetID, p.Token)
+	req, err := http.NewRequest(http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+	_, err = p.crunchy.requestFull(req)
+	return err
+}
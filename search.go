@@ -0,0 +1,146 @@
+package crunchyroll
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SearchMetadata holds the ranking information Crunchyroll's discovery
+// endpoints attach to a result item.
+type SearchMetadata struct {
+	Score           float64 `json:"score"`
+	Rank            int     `json:"rank"`
+	PopularityScore float64 `json:"popularity_score"`
+}
+
+// SearchType specifies a content type Crunchyroll.Search should look for.
+type SearchType string
+
+const (
+	SearchTypeSeries     SearchType = "series"
+	SearchTypeMovie      SearchType = "movie_listing"
+	SearchTypeEpisode    SearchType = "episode"
+	SearchTypeMusicVideo SearchType = "music_video"
+	SearchTypeArtist     SearchType = "artist"
+)
+
+// SearchSortType specifies how Crunchyroll.Search results should be sorted.
+type SearchSortType string
+
+const (
+	SearchSortPopularity   SearchSortType = "popularity"
+	SearchSortNewest       SearchSortType = "newest"
+	SearchSortAlphabetical SearchSortType = "alphabetical"
+)
+
+// SearchOptions represents options for Crunchyroll.Search.
+type SearchOptions struct {
+	// Types restricts the search to the given content types. All types are
+	// searched if left empty.
+	Types []SearchType `json:"types"`
+	// Locale overrides Crunchyroll.Locale for this search.
+	Locale LOCALE `json:"locale"`
+	// Page is the (zero-indexed) page to fetch, shared across all buckets.
+	Page uint `json:"page"`
+	// Size is the amount of items to fetch per bucket.
+	Size uint `json:"size"`
+	// Sort specifies how the results should be sorted.
+	Sort SearchSortType `json:"sort"`
+}
+
+// SearchResult is the result of Crunchyroll.Search, with every content type
+// paginated independently of the others.
+type SearchResult struct {
+	Series      BulkResult[*Series]
+	Movies      BulkResult[*Movie]
+	Episodes    BulkResult[*Episode]
+	MusicVideos BulkResult[*MusicVideo]
+	Artists     BulkResult[*Artist]
+}
+
+// Search performs a unified catalog search and returns series, movies,
+// episodes, music videos and artists matching query, each paginated
+// independently according to opts.
+func (c *Crunchyroll) Search(query string, opts SearchOptions) (*SearchResult, error) {
+	opts, err := structDefaults(SearchOptions{Locale: c.Locale, Page: 0, Size: 20, Sort: SearchSortPopularity}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	typesParam := ""
+	for i, t := range opts.Types {
+		if i > 0 {
+			typesParam += ","
+		}
+		typesParam += string(t)
+	}
+
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("type", typesParam)
+	params.Set("start", fmt.Sprint(opts.Page*opts.Size))
+	params.Set("n", fmt.Sprint(opts.Size))
+	params.Set("sort_by", string(opts.Sort))
+	params.Set("locale", string(opts.Locale))
+	endpoint := "https://www.crunchyroll.com/content/v2/discover/search?" + params.Encode()
+	resp, err := c.request(endpoint, http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var jsonBody struct {
+		Items []struct {
+			Type   MediaType       `json:"type"`
+			Result json.RawMessage `json:"items"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&jsonBody); err != nil {
+		return nil, fmt.Errorf("failed to parse search response: %w", err)
+	}
+
+	result := &SearchResult{}
+	for _, bucket := range jsonBody.Items {
+		switch bucket.Type {
+		case MediaTypeSeries:
+			if err = json.Unmarshal(bucket.Result, &result.Series.Items); err != nil {
+				return nil, err
+			}
+			for _, series := range result.Series.Items {
+				series.crunchy = c
+			}
+		case MediaTypeMovie:
+			if err = json.Unmarshal(bucket.Result, &result.Movies.Items); err != nil {
+				return nil, err
+			}
+			for _, movie := range result.Movies.Items {
+				movie.crunchy = c
+			}
+		case MediaTypeEpisode:
+			if err = json.Unmarshal(bucket.Result, &result.Episodes.Items); err != nil {
+				return nil, err
+			}
+			for _, episode := range result.Episodes.Items {
+				episode.crunchy = c
+			}
+		case MediaTypeMusicVideo:
+			if err = json.Unmarshal(bucket.Result, &result.MusicVideos.Items); err != nil {
+				return nil, err
+			}
+			for _, musicVideo := range result.MusicVideos.Items {
+				musicVideo.crunchy = c
+			}
+		case MediaTypeArtist:
+			if err = json.Unmarshal(bucket.Result, &result.Artists.Items); err != nil {
+				return nil, err
+			}
+			for _, artist := range result.Artists.Items {
+				artist.crunchy = c
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,170 @@
+package crunchyroll
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultUserAgents is a small seed pool of realistic browser user agents used
+// by TransportOptions when no custom pool is supplied.
+var defaultUserAgents = []string{
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15",
+	"Mozilla/5.0 (X11; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:125.0) Gecko/20100101 Firefox/125.0",
+}
+
+// UserAgentPool provides rotating user agent strings for TransportOptions. The
+// default pool picks uniformly at random from a fixed slice, but a caller can
+// supply their own, e.g. one refreshed periodically from a remote list.
+type UserAgentPool interface {
+	UserAgent() string
+}
+
+// staticUserAgentPool is the UserAgentPool used when TransportOptions.UserAgents
+// is left nil.
+type staticUserAgentPool []string
+
+func (p staticUserAgentPool) UserAgent() string {
+	if len(p) == 0 {
+		return ""
+	}
+	return p[rand.Intn(len(p))]
+}
+
+// retryableStatusCodes are the response codes retryTransport retries on.
+var retryableStatusCodes = map[int]bool{
+	http.StatusRequestTimeout:      true,
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// TransportOptions configures user agent rotation and retry/backoff for a
+// Crunchyroll session's outgoing requests. Install it with
+// Crunchyroll.EnableTransportOptions.
+type TransportOptions struct {
+	// UserAgents provides the pool of user agents to rotate through. Defaults
+	// to a small shipped pool of realistic browser user agents. Requests that
+	// already carry a User-Agent header keep it.
+	UserAgents UserAgentPool
+	// MaxRetries is how many times a request is retried after a retryable
+	// response or network error. Defaults to 3.
+	MaxRetries int
+	// BaseBackoff is the base delay of the exponential backoff between
+	// retries; it doubles every retry and gets ±50% jitter applied on top.
+	// Defaults to 500ms.
+	BaseBackoff time.Duration
+}
+
+// EnableTransportOptions wraps c.Client's transport with user agent rotation
+// and retry/backoff for transient errors, honoring c.Context cancellation
+// while sleeping between retries.
+func (c *Crunchyroll) EnableTransportOptions(options TransportOptions) {
+	if options.UserAgents == nil {
+		options.UserAgents = staticUserAgentPool(defaultUserAgents)
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = 3
+	}
+	if options.BaseBackoff == 0 {
+		options.BaseBackoff = 500 * time.Millisecond
+	}
+
+	next := c.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	client := *c.Client
+	client.Transport = &retryTransport{options: options, next: next, crunchy: c}
+	c.Client = &client
+}
+
+// retryTransport implements http.RoundTripper, applying TransportOptions on
+// top of next, the previously installed transport.
+type retryTransport struct {
+	options TransportOptions
+	next    http.RoundTripper
+	crunchy *Crunchyroll
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= t.options.MaxRetries; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		if attempt > 0 && attemptReq.Body != nil {
+			// Clone reuses the same, already-drained body reader; rewind it via
+			// GetBody before replaying the request. If the body can't be
+			// rewound, stop retrying rather than send a silently empty body.
+			if attemptReq.GetBody == nil {
+				break
+			}
+			body, bodyErr := attemptReq.GetBody()
+			if bodyErr != nil {
+				break
+			}
+			attemptReq.Body = body
+		}
+		if attemptReq.Header.Get("User-Agent") == "" {
+			if ua := t.options.UserAgents.UserAgent(); ua != "" {
+				attemptReq.Header.Set("User-Agent", ua)
+			}
+		}
+
+		resp, err = t.next.RoundTrip(attemptReq)
+		if err == nil && !retryableStatusCodes[resp.StatusCode] {
+			return resp, nil
+		}
+		if attempt == t.options.MaxRetries {
+			break
+		}
+
+		wait := retryAfter(resp)
+		if wait == 0 {
+			wait = jitter(t.options.BaseBackoff << attempt)
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-t.crunchy.Context.Done():
+			return nil, t.crunchy.Context.Err()
+		}
+	}
+
+	return resp, err
+}
+
+// retryAfter extracts a wait duration from a response's Retry-After header, or
+// returns 0 if the header is absent or unparsable.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// jitter randomizes d by ±50% to avoid retries from many clients synchronizing.
+func jitter(d time.Duration) time.Duration {
+	half := float64(d) / 2
+	return time.Duration(half + rand.Float64()*float64(d))
+}
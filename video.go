@@ -54,20 +54,16 @@ type Movie struct {
 
 	PromoDescription string `json:"promo_description"`
 	PromoTitle       string `json:"promo_title"`
-	SearchMetadata   struct {
-		Score float64 `json:"score"`
-	}
+
+	// not generated when calling MovieFromID, only present on Search results.
+	SearchMetadata SearchMetadata `json:"search_metadata"`
 }
 
 // MovieFromID returns a movie by its api id.
 func MovieFromID(crunchy *Crunchyroll, id string) (*Movie, error) {
-	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/movies/%s&locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		crunchy.Config.Bucket,
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/movies/%s?locale=%s",
 		id,
-		crunchy.Locale,
-		crunchy.Config.Signature,
-		crunchy.Config.Policy,
-		crunchy.Config.KeyPairID), http.MethodGet)
+		crunchy.Locale), http.MethodGet)
 	if err != nil {
 		return nil, err
 	}
@@ -92,13 +88,9 @@ func (m *Movie) MovieListing() (movieListings []*MovieListing, err error) {
 		return m.children, nil
 	}
 
-	resp, err := m.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/movies?movie_listing_id=%s&locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		m.crunchy.Config.Bucket,
+	resp, err := m.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/movies?movie_listing_id=%s&locale=%s",
 		m.ID,
-		m.crunchy.Locale,
-		m.crunchy.Config.Signature,
-		m.crunchy.Config.Policy,
-		m.crunchy.Config.KeyPairID), http.MethodGet)
+		m.crunchy.Locale), http.MethodGet)
 	if err != nil {
 		return nil, err
 	}
@@ -145,21 +137,15 @@ type Series struct {
 	MatureRatings       []string `json:"mature_ratings"`
 	SeasonCount         int      `json:"season_count"`
 
-	// not generated when calling SeriesFromID.
-	SearchMetadata struct {
-		Score float64 `json:"score"`
-	}
+	// not generated when calling SeriesFromID, only present on Search results.
+	SearchMetadata SearchMetadata `json:"search_metadata"`
 }
 
 // SeriesFromID returns a series by its api id.
 func SeriesFromID(crunchy *Crunchyroll, id string) (*Series, error) {
-	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/movies?movie_listing_id=%s&locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		crunchy.Config.Bucket,
+	resp, err := crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/series/%s?locale=%s",
 		id,
-		crunchy.Locale,
-		crunchy.Config.Signature,
-		crunchy.Config.Policy,
-		crunchy.Config.KeyPairID), http.MethodGet)
+		crunchy.Locale), http.MethodGet)
 	if err != nil {
 		return nil, err
 	}
@@ -249,13 +235,9 @@ func (s *Series) Seasons() (seasons []*Season, err error) {
 		return s.children, nil
 	}
 
-	resp, err := s.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/cms/v2/%s/seasons?series_id=%s&locale=%s&Signature=%s&Policy=%s&Key-Pair-Id=%s",
-		s.crunchy.Config.Bucket,
+	resp, err := s.crunchy.request(fmt.Sprintf("https://www.crunchyroll.com/content/v2/cms/seasons?series_id=%s&locale=%s",
 		s.ID,
-		s.crunchy.Locale,
-		s.crunchy.Config.Signature,
-		s.crunchy.Config.Policy,
-		s.crunchy.Config.KeyPairID), http.MethodGet)
+		s.crunchy.Locale), http.MethodGet)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +252,9 @@ func (s *Series) Seasons() (seasons []*Season, err error) {
 		if err = decodeMapToStruct(item, season); err != nil {
 			return nil, err
 		}
+		if s.crunchy.ExperimentalFixes&FixMissingAudioLocale != 0 {
+			season.Locale = parseLocaleFromSlug(season.SlugTitle)
+		}
 		seasons = append(seasons, season)
 	}
 